@@ -0,0 +1,42 @@
+//go:build faketime
+
+// Package faketime installs a deterministic clock for nanotime.Now,
+// letting tests control the passage of time. It only compiles into
+// binaries built with the faketime tag, so production builds pay no
+// cost for it.
+package faketime
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/timwmillard/nanotime"
+)
+
+// nanos is the simulated wall-clock reading, in nanoseconds since the
+// Unix epoch. It backs the default fake clock until SetNow installs a
+// custom hook.
+var nanos int64
+
+func init() {
+	nanotime.SetNowHook(defaultNow)
+}
+
+func defaultNow() nanotime.Nanotime {
+	return nanotime.Nanotime(atomic.LoadInt64(&nanos))
+}
+
+// Advance moves the simulated clock forward by d. d may be negative to
+// move it backward.
+func Advance(d time.Duration) {
+	atomic.AddInt64(&nanos, int64(d))
+}
+
+// SetNow installs f as the hook nanotime.Now consults, replacing the
+// default simulated clock. Passing nil restores the default.
+func SetNow(f func() nanotime.Nanotime) {
+	if f == nil {
+		f = defaultNow
+	}
+	nanotime.SetNowHook(f)
+}
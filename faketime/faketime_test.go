@@ -0,0 +1,30 @@
+//go:build faketime
+
+package faketime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/timwmillard/nanotime"
+)
+
+func TestAdvance(t *testing.T) {
+	SetNow(nil)
+	start := nanotime.Now()
+	Advance(5 * time.Second)
+	got := nanotime.Now()
+	if want := start + nanotime.Nanotime(5*time.Second); got != want {
+		t.Errorf("Now() after Advance = %d, want %d", got, want)
+	}
+}
+
+func TestSetNow(t *testing.T) {
+	want := nanotime.Nanotime(1234)
+	SetNow(func() nanotime.Nanotime { return want })
+	defer SetNow(nil)
+
+	if got := nanotime.Now(); got != want {
+		t.Errorf("Now() = %d, want %d", got, want)
+	}
+}
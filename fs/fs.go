@@ -0,0 +1,44 @@
+// Package fs sets filesystem access and modification times with full
+// nanosecond precision, using Nanotime values from the parent nanotime
+// package.
+//
+// SetTimes is available on all supported platforms. SetTimesFd, which
+// operates on an already-open file descriptor, is only available on
+// Linux and Windows; darwin and the BSDs have no nanosecond-precision
+// fd-based equivalent exposed by x/sys/unix.
+package fs
+
+import (
+	"time"
+
+	"github.com/timwmillard/nanotime"
+)
+
+// SameFsTime reports whether a and b should be treated as the same
+// timestamp on a filesystem that may only record whole-second
+// resolution. It returns true when the full nanosecond values match, or
+// when a and b fall within the same second and at least one of them has
+// a zero sub-second component — the shape produced by filesystems (and
+// tar archives) that truncate to 1-second resolution on write.
+func SameFsTime(a, b nanotime.Nanotime) bool {
+	if a == b {
+		return true
+	}
+	const second = int64(time.Second)
+	aSec, aSub := floorDivMod(int64(a), second)
+	bSec, bSub := floorDivMod(int64(b), second)
+	return aSec == bSec && (aSub == 0 || bSub == 0)
+}
+
+// floorDivMod returns the quotient and remainder of x/y using floored
+// (Euclidean-adjacent) division, so the remainder is always in
+// [0, y) even for negative x — unlike Go's truncating / and %, which
+// would otherwise bucket negative nanoseconds into the wrong second.
+func floorDivMod(x, y int64) (q, r int64) {
+	q, r = x/y, x%y
+	if r < 0 {
+		q--
+		r += y
+	}
+	return q, r
+}
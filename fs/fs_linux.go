@@ -0,0 +1,25 @@
+//go:build linux
+
+package fs
+
+import (
+	"fmt"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/timwmillard/nanotime"
+)
+
+// SetTimesFd sets the access and modification times of the file
+// referenced by fd, with full nanosecond precision.
+//
+// utimensat(2) has no way to target a bare fd directly (AT_EMPTY_PATH
+// requires CAP_DAC_READ_SEARCH and an O_PATH fd, which doesn't apply
+// here), so this goes through the /proc/self/fd/<fd> alias instead.
+// That alias is Linux-specific, so SetTimesFd is only available here
+// and on Windows (see fs_windows.go); darwin and the BSDs have no
+// nanosecond-precision fd-based equivalent exposed by x/sys/unix.
+func SetTimesFd(fd uintptr, atime, mtime nanotime.Nanotime) error {
+	path := fmt.Sprintf("/proc/self/fd/%d", fd)
+	return unix.UtimesNanoAt(unix.AT_FDCWD, path, timespecs(atime, mtime), 0)
+}
@@ -0,0 +1,42 @@
+//go:build linux
+
+package fs_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/timwmillard/nanotime"
+	"github.com/timwmillard/nanotime/fs"
+)
+
+func TestSetTimesFdRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "f")
+	if err := os.WriteFile(path, []byte("x"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	atime := nanotime.FromTime(time.Date(2020, 1, 2, 3, 4, 5, 123456789, time.UTC))
+	mtime := nanotime.FromTime(time.Date(2021, 6, 7, 8, 9, 10, 987654321, time.UTC))
+	if err := fs.SetTimesFd(f.Fd(), atime, mtime); err != nil {
+		t.Fatalf("SetTimesFd: %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotMtime := nanotime.FromTime(info.ModTime())
+	if gotMtime != mtime {
+		t.Errorf("mtime after SetTimesFd = %d, want %d", gotMtime, mtime)
+	}
+}
@@ -0,0 +1,97 @@
+package fs_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/timwmillard/nanotime"
+	"github.com/timwmillard/nanotime/fs"
+)
+
+func TestSetTimesRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "f")
+	if err := os.WriteFile(path, []byte("x"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	atime := nanotime.FromTime(time.Date(2020, 1, 2, 3, 4, 5, 123456789, time.UTC))
+	mtime := nanotime.FromTime(time.Date(2021, 6, 7, 8, 9, 10, 987654321, time.UTC))
+	if err := fs.SetTimes(path, atime, mtime, true); err != nil {
+		t.Fatalf("SetTimes: %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotMtime := nanotime.FromTime(info.ModTime())
+	if gotMtime != mtime {
+		t.Errorf("mtime after SetTimes = %d, want %d", gotMtime, mtime)
+	}
+}
+
+func TestSetTimesNoFollowSymlink(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "target")
+	link := filepath.Join(dir, "link")
+	if err := os.WriteFile(target, []byte("x"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(target, link); err != nil {
+		t.Skipf("symlinks unsupported: %v", err)
+	}
+
+	targetMtimeBefore, err := os.Stat(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	linkMtime := nanotime.FromTime(time.Date(2019, 3, 4, 5, 6, 7, 0, time.UTC))
+	if err := fs.SetTimes(link, linkMtime, linkMtime, false); err != nil {
+		t.Fatalf("SetTimes (no-follow): %v", err)
+	}
+
+	targetAfter, err := os.Stat(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !targetAfter.ModTime().Equal(targetMtimeBefore.ModTime()) {
+		t.Errorf("no-follow SetTimes modified symlink target's mtime")
+	}
+
+	linkAfter, err := os.Lstat(link)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := nanotime.FromTime(linkAfter.ModTime()); got != linkMtime {
+		t.Errorf("link mtime = %d, want %d", got, linkMtime)
+	}
+}
+
+func TestSameFsTime(t *testing.T) {
+	base := nanotime.FromTime(time.Date(2022, 5, 6, 7, 8, 9, 0, time.UTC))
+	withSub := base + 123
+
+	tests := []struct {
+		name string
+		a, b nanotime.Nanotime
+		want bool
+	}{
+		{"identical", base, base, true},
+		{"whole second truncated to zero", base, withSub, true},
+		{"both have sub-second, differ", withSub, withSub + 1, false},
+		{"different seconds", base, base + nanotime.Nanotime(time.Second), false},
+		{"pre-epoch, truncated to same whole second", nanotime.Nanotime(-999999999), nanotime.Nanotime(-1000000000), true},
+		{"straddles the epoch, different seconds", nanotime.Nanotime(-1), nanotime.Nanotime(0), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fs.SameFsTime(tt.a, tt.b); got != tt.want {
+				t.Errorf("SameFsTime(%d, %d) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
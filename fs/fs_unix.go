@@ -0,0 +1,28 @@
+//go:build !windows
+
+package fs
+
+import (
+	"golang.org/x/sys/unix"
+
+	"github.com/timwmillard/nanotime"
+)
+
+// SetTimes sets the access and modification times of path, with full
+// nanosecond precision. If followSymlinks is false and path names a
+// symlink, the symlink itself is updated rather than the file it points
+// to.
+func SetTimes(path string, atime, mtime nanotime.Nanotime, followSymlinks bool) error {
+	flags := 0
+	if !followSymlinks {
+		flags = unix.AT_SYMLINK_NOFOLLOW
+	}
+	return unix.UtimesNanoAt(unix.AT_FDCWD, path, timespecs(atime, mtime), flags)
+}
+
+func timespecs(atime, mtime nanotime.Nanotime) []unix.Timespec {
+	return []unix.Timespec{
+		unix.NsecToTimespec(int64(atime)),
+		unix.NsecToTimespec(int64(mtime)),
+	}
+}
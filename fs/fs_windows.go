@@ -0,0 +1,48 @@
+//go:build windows
+
+package fs
+
+import (
+	"golang.org/x/sys/windows"
+
+	"github.com/timwmillard/nanotime"
+)
+
+// SetTimes sets the access and modification times of path, with full
+// nanosecond precision (down to FILETIME's 100-nanosecond resolution).
+// If followSymlinks is false and path names a reparse point, the
+// reparse point itself is updated rather than the file it points to.
+func SetTimes(path string, atime, mtime nanotime.Nanotime, followSymlinks bool) error {
+	p, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return err
+	}
+	flags := uint32(windows.FILE_FLAG_BACKUP_SEMANTICS)
+	if !followSymlinks {
+		flags |= windows.FILE_FLAG_OPEN_REPARSE_POINT
+	}
+	h, err := windows.CreateFile(p, windows.FILE_WRITE_ATTRIBUTES, windows.FILE_SHARE_READ|windows.FILE_SHARE_WRITE, nil, windows.OPEN_EXISTING, flags, 0)
+	if err != nil {
+		return err
+	}
+	defer windows.CloseHandle(h)
+	return setFileTime(windows.Handle(h), atime, mtime)
+}
+
+// SetTimesFd sets the access and modification times of the file
+// referenced by fd, with full nanosecond precision (down to FILETIME's
+// 100-nanosecond resolution).
+func SetTimesFd(fd uintptr, atime, mtime nanotime.Nanotime) error {
+	return setFileTime(windows.Handle(fd), atime, mtime)
+}
+
+func setFileTime(h windows.Handle, atime, mtime nanotime.Nanotime) error {
+	at := toFiletime(atime)
+	mt := toFiletime(mtime)
+	return windows.SetFileTime(h, nil, &at, &mt)
+}
+
+func toFiletime(n nanotime.Nanotime) windows.Filetime {
+	ft := n.ToFileTime()
+	return windows.Filetime{LowDateTime: ft.LowDateTime, HighDateTime: ft.HighDateTime}
+}
@@ -0,0 +1,148 @@
+// Package nanotime provides a nanosecond-precision timestamp type,
+// Nanotime, and conversions to and from the time representations most
+// commonly encountered when interoperating with other systems: Go's
+// time.Time, Windows FILETIME, and protobuf's well-known Timestamp.
+package nanotime
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Nanotime is a point in time expressed as nanoseconds since the Unix
+// epoch (1970-01-01T00:00:00Z), positive or negative.
+type Nanotime int64
+
+// unixToFileTimeOffset is the number of 100-nanosecond intervals between
+// the Windows FILETIME epoch (1601-01-01T00:00:00Z) and the Unix epoch
+// (1970-01-01T00:00:00Z).
+const unixToFileTimeOffset = 116444736000000000
+
+// Protobuf Timestamp valid range, per timestamp.proto: from
+// 0001-01-01T00:00:00Z to 9999-12-31T23:59:59.999999999Z inclusive.
+const (
+	minProtoSeconds int64 = -62135596800
+	maxProtoSeconds int64 = 253402300800
+)
+
+// Nanotime is an int64 count of nanoseconds, so it can only represent
+// instants within about [1677-09-21, 2262-04-11] of the Unix epoch -
+// far narrower than protobuf's Timestamp range. These bound Seconds to
+// what the seconds-to-nanoseconds conversion in FromProto can compute
+// without overflowing int64.
+const (
+	minNanotimeSeconds    = math.MinInt64 / int64(time.Second)
+	maxNanotimeSeconds    = math.MaxInt64 / int64(time.Second)
+	maxNanotimeNanosAtMax = math.MaxInt64 % int64(time.Second)
+)
+
+// FromTime converts a time.Time to a Nanotime.
+func FromTime(t time.Time) Nanotime {
+	return Nanotime(t.UnixNano())
+}
+
+// ToTime converts n to a time.Time in the UTC location.
+func (n Nanotime) ToTime() time.Time {
+	return time.Unix(0, int64(n)).UTC()
+}
+
+// FileTime is a Windows FILETIME: the number of 100-nanosecond intervals
+// since 1601-01-01T00:00:00Z, split into low and high 32-bit words.
+type FileTime struct {
+	LowDateTime  uint32
+	HighDateTime uint32
+}
+
+// ticks returns the FILETIME value as a single 64-bit count of
+// 100-nanosecond intervals since 1601-01-01T00:00:00Z.
+func (f FileTime) ticks() int64 {
+	return int64(uint64(f.HighDateTime)<<32 | uint64(f.LowDateTime))
+}
+
+// FromFileTime converts a Windows FILETIME to a Nanotime.
+func FromFileTime(f FileTime) Nanotime {
+	return Nanotime((f.ticks() - unixToFileTimeOffset) * 100)
+}
+
+// ToFileTime converts n to a Windows FILETIME. Sub-100-nanosecond
+// precision is truncated toward the past, as FILETIME cannot
+// represent it.
+func (n Nanotime) ToFileTime() FileTime {
+	ticks := floorDiv(int64(n), 100) + unixToFileTimeOffset
+	u := uint64(ticks)
+	return FileTime{
+		LowDateTime:  uint32(u),
+		HighDateTime: uint32(u >> 32),
+	}
+}
+
+// floorDiv returns the quotient of x/y rounded toward negative
+// infinity, unlike Go's built-in / which truncates toward zero. This
+// keeps sub-unit truncation consistently rounding toward the past
+// regardless of the sign of x.
+func floorDiv(x, y int64) int64 {
+	q := x / y
+	if x%y != 0 && (x < 0) != (y < 0) {
+		q--
+	}
+	return q
+}
+
+// Timestamp is a protobuf-style timestamp, matching the shape of
+// google.protobuf.Timestamp: seconds and nanoseconds since the Unix
+// epoch, with Nanos always in [0, 999999999].
+type Timestamp struct {
+	Seconds int64
+	Nanos   int32
+}
+
+// FromProto converts a Timestamp to a Nanotime. It returns an error if
+// ts falls outside the range representable by google.protobuf.Timestamp
+// (0001-01-01T00:00:00Z up to, but not including, 10000-01-01T00:00:00Z).
+func FromProto(ts Timestamp) (Nanotime, error) {
+	if ts.Seconds < minProtoSeconds || ts.Seconds >= maxProtoSeconds {
+		return 0, fmt.Errorf("nanotime: seconds %d out of range [%d, %d)", ts.Seconds, minProtoSeconds, maxProtoSeconds)
+	}
+	if ts.Nanos < 0 || ts.Nanos > 999999999 {
+		return 0, fmt.Errorf("nanotime: nanos %d out of range [0, 999999999]", ts.Nanos)
+	}
+	if ts.Seconds < minNanotimeSeconds || ts.Seconds > maxNanotimeSeconds ||
+		(ts.Seconds == maxNanotimeSeconds && int64(ts.Nanos) > maxNanotimeNanosAtMax) {
+		return 0, fmt.Errorf("nanotime: seconds %d overflows Nanotime's int64 nanosecond range", ts.Seconds)
+	}
+	return Nanotime(ts.Seconds*int64(time.Second) + int64(ts.Nanos)), nil
+}
+
+// ToProto converts n to a protobuf-style Timestamp, with Nanos
+// normalized into [0, 999999999].
+func (n Nanotime) ToProto() Timestamp {
+	seconds := int64(n) / int64(time.Second)
+	nanos := int64(n) % int64(time.Second)
+	if nanos < 0 {
+		nanos += int64(time.Second)
+		seconds--
+	}
+	return Timestamp{Seconds: seconds, Nanos: int32(nanos)}
+}
+
+// Parse parses an RFC3339 timestamp with nanosecond precision.
+func Parse(s string) (Nanotime, error) {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return 0, err
+	}
+	return FromTime(t), nil
+}
+
+// Format renders n as an RFC3339 timestamp with nanosecond precision, in
+// the UTC location.
+func (n Nanotime) Format() string {
+	return n.ToTime().Format(time.RFC3339Nano)
+}
+
+// String implements fmt.Stringer, formatting n as RFC3339 with
+// nanosecond precision.
+func (n Nanotime) String() string {
+	return n.Format()
+}
@@ -0,0 +1,140 @@
+package nanotime
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestFromToTime(t *testing.T) {
+	tests := []struct {
+		name string
+		nt   Nanotime
+	}{
+		{"epoch", 0},
+		{"positive", 1_600_000_000_123_456_789},
+		{"before epoch", -1_600_000_000_123_456_789},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FromTime(tt.nt.ToTime())
+			if got != tt.nt {
+				t.Errorf("round trip through time.Time = %d, want %d", got, tt.nt)
+			}
+		})
+	}
+}
+
+func TestFileTimeRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		nt   Nanotime
+	}{
+		{"unix epoch", 0},
+		{"before unix epoch", -1_600_000_000_000_000_000},
+		{"well before unix epoch", -unixToFileTimeOffset * 100 / 2},
+		{"modern", 1_700_000_000_000_000_000},
+		{"sub-100ns after epoch", 99},
+		{"sub-100ns before epoch", -99},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// FILETIME has 100ns resolution, so truncate to that before
+			// comparing. Truncation always rounds toward the past,
+			// regardless of sign, so use floor division here too.
+			want := Nanotime(floorDiv(int64(tt.nt), 100) * 100)
+			got := FromFileTime(tt.nt.ToFileTime())
+			if got != want {
+				t.Errorf("round trip through FileTime = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestToFileTimeRoundsTowardPast(t *testing.T) {
+	// 99ns either side of the epoch must both truncate down to the
+	// start of their containing 100ns tick, not toward zero.
+	if got := FromFileTime(Nanotime(99).ToFileTime()); got != 0 {
+		t.Errorf("FromFileTime(ToFileTime(99)) = %d, want 0", got)
+	}
+	if got := FromFileTime(Nanotime(-99).ToFileTime()); got != -100 {
+		t.Errorf("FromFileTime(ToFileTime(-99)) = %d, want -100", got)
+	}
+}
+
+func TestFromFileTimeKnownValue(t *testing.T) {
+	// The Unix epoch as FILETIME is exactly the Unix epoch offset.
+	offset := uint64(unixToFileTimeOffset)
+	ft := FileTime{
+		LowDateTime:  uint32(offset),
+		HighDateTime: uint32(offset >> 32),
+	}
+	if got := FromFileTime(ft); got != 0 {
+		t.Errorf("FromFileTime(unixToFileTimeOffset) = %d, want 0", got)
+	}
+}
+
+func TestFromProto(t *testing.T) {
+	tests := []struct {
+		name    string
+		ts      Timestamp
+		want    Nanotime
+		wantErr bool
+	}{
+		{"epoch", Timestamp{Seconds: 0, Nanos: 0}, 0, false},
+		{"nanos set", Timestamp{Seconds: 1, Nanos: 500}, Nanotime(time.Second) + 500, false},
+		{"seconds too small", Timestamp{Seconds: minProtoSeconds - 1, Nanos: 0}, 0, true},
+		{"seconds too large", Timestamp{Seconds: maxProtoSeconds, Nanos: 0}, 0, true},
+		{"nanos negative", Timestamp{Seconds: 0, Nanos: -1}, 0, true},
+		{"nanos too large", Timestamp{Seconds: 0, Nanos: 1_000_000_000}, 0, true},
+		{"seconds within proto range but overflows int64 nanos", Timestamp{Seconds: maxNanotimeSeconds + 1, Nanos: 0}, 0, true},
+		{"seconds at int64 nanos boundary, nanos push past it", Timestamp{Seconds: maxNanotimeSeconds, Nanos: int32(maxNanotimeNanosAtMax) + 1}, 0, true},
+		{"seconds at int64 nanos boundary, nanos within it", Timestamp{Seconds: maxNanotimeSeconds, Nanos: int32(maxNanotimeNanosAtMax)}, Nanotime(math.MaxInt64), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromProto(tt.ts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromProto(%+v) error = %v, wantErr %v", tt.ts, err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("FromProto(%+v) = %d, want %d", tt.ts, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToProtoNormalizesNegativeNanos(t *testing.T) {
+	// -500ns should normalize to Seconds: -1, Nanos: 999999500, not
+	// Seconds: 0, Nanos: -500.
+	got := Nanotime(-500).ToProto()
+	want := Timestamp{Seconds: -1, Nanos: 999999500}
+	if got != want {
+		t.Errorf("ToProto() = %+v, want %+v", got, want)
+	}
+}
+
+func TestProtoRoundTrip(t *testing.T) {
+	tests := []Nanotime{0, 1, -1, 1_600_000_000_123_456_789, -1_600_000_000_123_456_789}
+	for _, nt := range tests {
+		ts := nt.ToProto()
+		got, err := FromProto(ts)
+		if err != nil {
+			t.Fatalf("FromProto(%+v) returned error: %v", ts, err)
+		}
+		if got != nt {
+			t.Errorf("round trip through Timestamp = %d, want %d", got, nt)
+		}
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	const s = "2020-06-15T12:34:56.789012345Z"
+	nt, err := Parse(s)
+	if err != nil {
+		t.Fatalf("Parse(%q) returned error: %v", s, err)
+	}
+	if got := nt.Format(); got != s {
+		t.Errorf("Format() = %q, want %q", got, s)
+	}
+}
@@ -0,0 +1,20 @@
+//go:build !faketime
+
+package nanotime
+
+import "time"
+
+// monoToUnixOffset is added to a runtimeNano reading to turn it into
+// nanoseconds since the Unix epoch. It is calibrated once at package
+// init by comparing the monotonic clock against the wall clock, since
+// runtimeNano's origin (e.g. time since boot on Linux) is otherwise
+// arbitrary and platform-specific.
+var monoToUnixOffset = time.Now().UnixNano() - runtimeNano()
+
+// Now returns the current time as a Nanotime, read from the runtime's
+// monotonic clock and calibrated against the wall clock at package
+// init. Build with the faketime tag to substitute a deterministic
+// clock controlled by the nanotime/faketime subpackage.
+func Now() Nanotime {
+	return Nanotime(runtimeNano() + monoToUnixOffset)
+}
@@ -0,0 +1,19 @@
+//go:build faketime
+
+package nanotime
+
+// nowHook is consulted by Now while the faketime build tag is active.
+// The nanotime/faketime subpackage installs it via SetNowHook; until
+// then Now reports the Unix epoch.
+var nowHook = func() Nanotime { return 0 }
+
+// SetNowHook installs f as the function Now consults. It is intended to
+// be called by the nanotime/faketime subpackage, not application code.
+func SetNowHook(f func() Nanotime) {
+	nowHook = f
+}
+
+// Now returns the value most recently installed via SetNowHook.
+func Now() Nanotime {
+	return nowHook()
+}
@@ -0,0 +1,13 @@
+//go:build !faketime && 386
+
+package nanotime
+
+import "time"
+
+// runtimeNano falls back to time.Now().UnixNano() on 386. The
+// go:linkname trick used on other architectures (see now_linkname.go)
+// is untested on 386, so this conservatively uses the portable path
+// there instead.
+func runtimeNano() int64 {
+	return time.Now().UnixNano()
+}
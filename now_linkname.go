@@ -0,0 +1,13 @@
+//go:build !faketime && !386
+
+package nanotime
+
+import (
+	_ "unsafe" // for go:linkname
+)
+
+// runtimeNano reads the runtime's monotonic clock directly, avoiding the
+// allocation and wall-clock/monotonic split that time.Now() performs.
+//
+//go:linkname runtimeNano runtime.nanotime1
+func runtimeNano() int64
@@ -0,0 +1,26 @@
+package nanotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNowAdvances(t *testing.T) {
+	a := Now()
+	b := Now()
+	if b < a {
+		t.Errorf("Now() went backwards: %d then %d", a, b)
+	}
+}
+
+func BenchmarkNowLinkname(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = Now()
+	}
+}
+
+func BenchmarkTimeNowUnixNano(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = time.Now().UnixNano()
+	}
+}